@@ -2,12 +2,16 @@ package main
 
 import (
 	"fmt"
+	"log"
+	"os"
+	"path/filepath"
 	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	fiberSwagger "github.com/swaggo/fiber-swagger"
 
 	_ "github.com/NKI-AI/rules-go-swag/examples/gofiber/docs"
+	"github.com/NKI-AI/rules-go-swag/swagvalidate"
 )
 
 // @title           Pet Store API
@@ -31,6 +35,12 @@ import (
 func main() {
 	app := fiber.New()
 
+	if validator, err := swagvalidate.New(findSwaggerJSON(), swagvalidate.Config{Mode: swagvalidate.ModeStrict}); err != nil {
+		log.Printf("swagvalidate: disabled, could not load swagger.json: %s", err)
+	} else {
+		app.Use(validator.FiberMiddleware())
+	}
+
 	// Swagger UI endpoint
 	app.Get("/swagger/*", fiberSwagger.WrapHandler)
 
@@ -124,13 +134,27 @@ func getPet(c *fiber.Ctx) error {
 // @Security     BearerAuth
 func createPet(c *fiber.Ctx) error {
 	var pet Pet
-	if err := c.BodyParser(&pet); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
-			Code:    400,
-			Message: "Invalid request",
-		})
-	}
-	pet.ID = 3 // Assign new ID
+	c.BodyParser(&pet) // shape already validated by swagvalidate.FiberMiddleware
+	pet.ID = 3         // Assign new ID
 	return c.Status(fiber.StatusCreated).JSON(pet)
 }
 
+// findSwaggerJSON locates the swagger.json file in various possible locations.
+func findSwaggerJSON() string {
+	possiblePaths := []string{
+		filepath.Join(os.Getenv("RUNFILES_DIR"), "_main", "examples", "gofiber", "docs", "swagger.json"),
+		"examples/gofiber/docs/swagger.json",
+		"docs/swagger.json",
+		filepath.Join(os.Getenv("BUILD_WORKSPACE_DIRECTORY"), "examples", "gofiber", "docs", "swagger.json"),
+	}
+
+	for _, path := range possiblePaths {
+		if path != "" {
+			if _, err := os.Stat(path); err == nil {
+				return path
+			}
+		}
+	}
+
+	return ""
+}
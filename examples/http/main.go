@@ -3,10 +3,13 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
+
+	"github.com/NKI-AI/rules-go-swag/swagvalidate"
 )
 
 // @title           Pet Store API
@@ -48,7 +51,14 @@ func main() {
 	fmt.Println("  - http://localhost:8080/swagger/")
 	fmt.Println("  - http://localhost:8080/swagger/swagger.json")
 
-	if err := http.ListenAndServe(":8080", nil); err != nil {
+	handler := http.DefaultServeMux.ServeHTTP
+	if validator, err := swagvalidate.New(findSwaggerJSON(), swagvalidate.Config{Mode: swagvalidate.ModeStrict}); err != nil {
+		log.Printf("swagvalidate: disabled, could not load swagger.json: %s", err)
+	} else {
+		handler = validator.Middleware(http.DefaultServeMux).ServeHTTP
+	}
+
+	if err := http.ListenAndServe(":8080", http.HandlerFunc(handler)); err != nil {
 		fmt.Printf("Failed to start server: %s\n", err)
 	}
 }
@@ -144,12 +154,8 @@ func getPet(w http.ResponseWriter, r *http.Request) {
 // @Security     BearerAuth
 func createPet(w http.ResponseWriter, r *http.Request) {
 	var pet Pet
-	if err := json.NewDecoder(r.Body).Decode(&pet); err != nil {
-		w.WriteHeader(http.StatusBadRequest)
-		json.NewEncoder(w).Encode(ErrorResponse{Code: 400, Message: "Invalid request"})
-		return
-	}
-	pet.ID = 3 // Assign new ID
+	json.NewDecoder(r.Body).Decode(&pet) // shape already validated by swagvalidate.Middleware
+	pet.ID = 3                           // Assign new ID
 	w.WriteHeader(http.StatusCreated)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(pet)
@@ -168,7 +174,7 @@ func findSwaggerJSON() string {
 		// Absolute workspace path
 		filepath.Join(os.Getenv("BUILD_WORKSPACE_DIRECTORY"), "examples", "http", "docs", "swagger.json"),
 	}
-	
+
 	for _, path := range possiblePaths {
 		if path != "" {
 			if _, err := os.Stat(path); err == nil {
@@ -176,7 +182,7 @@ func findSwaggerJSON() string {
 			}
 		}
 	}
-	
+
 	return ""
 }
 
@@ -187,13 +193,13 @@ func handleSwaggerJSON(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "Swagger documentation not found. Run: bazel run //examples/http:generate_docs", http.StatusNotFound)
 		return
 	}
-	
+
 	data, err := os.ReadFile(swaggerPath)
 	if err != nil {
 		http.Error(w, "Error reading swagger documentation: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
-	
+
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	w.Write(data)
@@ -245,7 +251,7 @@ func handleSwaggerUI(w http.ResponseWriter, r *http.Request) {
     </script>
 </body>
 </html>`
-	
+
 	w.Header().Set("Content-Type", "text/html")
 	w.Write([]byte(html))
 }
@@ -0,0 +1,52 @@
+// Command grpc-gateway-bridge reads the google.api.http options out of a
+// .proto file and writes Go stubs carrying the equivalent swag annotation
+// blocks, so a service's gRPC-gateway routes can be scanned into the same
+// swagger.json produced for examples/http and examples/gofiber. This
+// repository ships no Bazel workspace, so it is run directly (go run
+// ./grpcgateway/cmd/grpc-gateway-bridge ...) the same way as the example/,
+// examples/http and examples/gofiber mains; a Bazel rule wrapping this
+// binary as its action is future work, not something this snapshot
+// provides.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/NKI-AI/rules-go-swag/grpcgateway"
+)
+
+func main() {
+	protoPath := flag.String("proto", "", "path to the .proto file declaring the gateway service")
+	outPath := flag.String("out", "", "path to write generated annotation stubs (default stdout)")
+	pkg := flag.String("package", "main", "package name for generated stubs")
+	flag.Parse()
+
+	if *protoPath == "" {
+		fmt.Fprintln(os.Stderr, "grpc-gateway-bridge: -proto is required")
+		os.Exit(1)
+	}
+
+	methods, err := grpcgateway.Parse(*protoPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "grpc-gateway-bridge: %s\n", err)
+		os.Exit(1)
+	}
+
+	src, err := grpcgateway.GenerateAnnotations(methods, grpcgateway.Options{Package: *pkg})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "grpc-gateway-bridge: %s\n", err)
+		os.Exit(1)
+	}
+
+	if *outPath == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*outPath, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "grpc-gateway-bridge: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("grpc-gateway-bridge: wrote %s\n", *outPath)
+}
@@ -0,0 +1,12 @@
+// Package grpcgateway bridges grpc-gateway's google.api.http proto options
+// into the swag annotation style used throughout this repository. It reads
+// the .proto files behind a generated gateway (the pattern typical of Go-kit
+// / go-micro / grpc-gateway projects) and emits Go stubs carrying
+// // @Router/@Param/@Success blocks equivalent to the http option on each
+// rpc, so a service that mixes gRPC and REST can document both through the
+// same swag annotation scan without hand-duplicating annotations on
+// generated gateway code. This snapshot has no Bazel workspace, so there is
+// no swag_docs rule wired to call it yet; grpc-gateway-bridge is run
+// directly the same way as the example/, examples/http and examples/gofiber
+// mains.
+package grpcgateway
@@ -0,0 +1,80 @@
+package grpcgateway
+
+import (
+	"fmt"
+	"go/format"
+	"strings"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+// Options controls GenerateAnnotations.
+type Options struct {
+	// Package is the generated file's package name. Defaults to "main".
+	Package string
+}
+
+// GenerateAnnotations renders one Go stub per Method, carrying the
+// @Summary/@Router/@Param block equivalent to its google.api.http option, so
+// its output can be scanned into the same swagger.json as examples/http and
+// examples/gofiber.
+func GenerateAnnotations(methods []Method, opts Options) ([]byte, error) {
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = "main"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Code generated by the grpc-gateway bridge. DO NOT EDIT.\n\npackage %s\n\nimport \"net/http\"\n", pkg)
+
+	for _, m := range methods {
+		b.WriteString(renderMethod(m))
+	}
+
+	return format.Source([]byte(b.String()))
+}
+
+func renderMethod(m Method) string {
+	name := lowerCamel(m.RPC)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n// %s godoc\n", name)
+	if m.Summary != "" {
+		fmt.Fprintf(&b, "// @Summary      %s\n", m.Summary)
+	}
+	if m.Description != "" {
+		fmt.Fprintf(&b, "// @Description  %s\n", m.Description)
+	}
+	if m.Service != "" {
+		fmt.Fprintf(&b, "// @Tags         %s\n", m.Service)
+	}
+	b.WriteString("// @Accept       json\n")
+	b.WriteString("// @Produce      json\n")
+
+	for _, name := range swagclient.PathParamNames(m.Path) {
+		fmt.Fprintf(&b, "// @Param        %s   path      string  true  \"%s\"\n", name, name)
+	}
+	if m.Body != "" {
+		fmt.Fprintf(&b, "// @Param        body  body      %s  true  \"request body\"\n", m.Request)
+	}
+	if m.Response != "" {
+		fmt.Fprintf(&b, "// @Success      200  {object}  %s\n", m.Response)
+	}
+
+	fmt.Fprintf(&b, "// @Router       %s [%s]\n", m.Path, strings.ToLower(m.HTTPMethod))
+	fmt.Fprintf(&b, "func %s(w http.ResponseWriter, r *http.Request) {\n", name)
+	fmt.Fprintf(&b, "\t// TODO: wire %s to the %s.%s gateway handler generated from the .proto.\n", name, m.Service, m.RPC)
+	b.WriteString("\thttp.Error(w, \"not implemented\", http.StatusNotImplemented)\n")
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// lowerCamel turns an exported RPC name like "GetPet" into the unexported
+// handler name style used throughout the examples ("getPet").
+func lowerCamel(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,78 @@
+package grpcgateway
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateAnnotations(t *testing.T) {
+	methods := []Method{
+		{
+			Service:  "PetService",
+			RPC:      "GetPet",
+			Request:  "GetPetRequest",
+			Response: "Pet",
+			Summary:  "Get a pet",
+			Path:     "/v1/pets/{id}",
+		},
+	}
+	methods[0].HTTPMethod = "GET"
+
+	src, err := GenerateAnnotations(methods, Options{Package: "main"})
+	if err != nil {
+		t.Fatalf("GenerateAnnotations: %s", err)
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		"func getPet(w http.ResponseWriter, r *http.Request)",
+		"// @Summary      Get a pet",
+		"// @Tags         PetService",
+		`// @Param        id   path      string  true  "id"`,
+		"// @Success      200  {object}  Pet",
+		"// @Router       /v1/pets/{id} [get]",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("GenerateAnnotations() missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestGenerateAnnotationsWithBody(t *testing.T) {
+	methods := []Method{
+		{
+			Service:    "PetService",
+			RPC:        "CreatePet",
+			Request:    "CreatePetRequest",
+			Response:   "Pet",
+			Path:       "/v1/pets",
+			HTTPMethod: "POST",
+			Body:       "*",
+		},
+	}
+
+	src, err := GenerateAnnotations(methods, Options{Package: "main"})
+	if err != nil {
+		t.Fatalf("GenerateAnnotations: %s", err)
+	}
+	out := string(src)
+
+	if !strings.Contains(out, `// @Param        body  body      CreatePetRequest  true  "request body"`) {
+		t.Errorf("GenerateAnnotations() missing body param, got:\n%s", out)
+	}
+}
+
+func TestLowerCamel(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"GetPet", "getPet"},
+		{"ListPets", "listPets"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := lowerCamel(tt.in); got != tt.want {
+			t.Errorf("lowerCamel(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
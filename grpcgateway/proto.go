@@ -0,0 +1,107 @@
+package grpcgateway
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Method is one rpc annotated with a google.api.http option, extracted from
+// a .proto file.
+type Method struct {
+	Service     string
+	RPC         string
+	Request     string
+	Response    string
+	HTTPMethod  string // GET, POST, ...
+	Path        string // e.g. "/v1/pets/{id}"
+	Body        string // "*", a field name, or "" when the option has none
+	Summary     string
+	Description string
+}
+
+var (
+	serviceRe  = regexp.MustCompile(`^\s*service\s+(\w+)\s*\{`)
+	rpcRe      = regexp.MustCompile(`^\s*rpc\s+(\w+)\s*\(([\w.]+)\)\s*returns\s*\(([\w.]+)\)\s*\{`)
+	httpVerbRe = regexp.MustCompile(`^\s*(get|post|put|patch|delete)\s*:\s*"([^"]*)"`)
+	bodyRe     = regexp.MustCompile(`^\s*body\s*:\s*"([^"]*)"`)
+	commentRe  = regexp.MustCompile(`^\s*//\s?(.*)$`)
+)
+
+// Parse scans protoPath for service/rpc blocks carrying a
+// "option (google.api.http) = {...}" and returns one Method per such rpc.
+// It is a line-oriented scan rather than a full proto grammar: good enough
+// for the google.api.http shape grpc-gateway projects actually write, not a
+// general-purpose .proto parser.
+func Parse(protoPath string) ([]Method, error) {
+	f, err := os.Open(protoPath)
+	if err != nil {
+		return nil, fmt.Errorf("grpcgateway: open %s: %w", protoPath, err)
+	}
+	defer f.Close()
+
+	var (
+		methods []Method
+		service string
+		pending []string
+		inRPC   bool
+		cur     Method
+	)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if m := commentRe.FindStringSubmatch(line); m != nil {
+			pending = append(pending, m[1])
+			continue
+		}
+
+		if m := serviceRe.FindStringSubmatch(line); m != nil {
+			service = m[1]
+			pending = nil
+			continue
+		}
+
+		if m := rpcRe.FindStringSubmatch(line); m != nil {
+			cur = Method{Service: service, RPC: m[1], Request: m[2], Response: m[3]}
+			if len(pending) > 0 {
+				cur.Summary = pending[0]
+				cur.Description = strings.Join(pending[1:], " ")
+			}
+			pending = nil
+			inRPC = true
+			continue
+		}
+
+		if !inRPC {
+			pending = nil
+			continue
+		}
+
+		if m := httpVerbRe.FindStringSubmatch(line); m != nil {
+			cur.HTTPMethod = strings.ToUpper(m[1])
+			cur.Path = m[2]
+			continue
+		}
+		if m := bodyRe.FindStringSubmatch(line); m != nil {
+			cur.Body = m[1]
+			continue
+		}
+
+		// The google.api.http option block closes one line before the rpc
+		// block itself; once a verb has been seen, the next closing brace
+		// is that option block closing, so the method is complete.
+		if strings.Contains(line, "}") && cur.HTTPMethod != "" {
+			methods = append(methods, cur)
+			inRPC = false
+			cur = Method{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("grpcgateway: read %s: %w", protoPath, err)
+	}
+	return methods, nil
+}
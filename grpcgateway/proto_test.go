@@ -0,0 +1,125 @@
+package grpcgateway
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeProtoFixture(t *testing.T, src string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "service.proto")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %s", err)
+	}
+	return path
+}
+
+func TestParseSingleRPC(t *testing.T) {
+	path := writeProtoFixture(t, `syntax = "proto3";
+
+service PetService {
+  // Get a pet
+  rpc GetPet(GetPetRequest) returns (Pet) {
+    option (google.api.http) = {
+      get: "/v1/pets/{id}"
+    };
+  }
+}
+`)
+
+	methods, err := Parse(path)
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+	if len(methods) != 1 {
+		t.Fatalf("Parse() = %d methods, want 1: %+v", len(methods), methods)
+	}
+
+	m := methods[0]
+	if m.Service != "PetService" || m.RPC != "GetPet" || m.Request != "GetPetRequest" || m.Response != "Pet" {
+		t.Errorf("Parse() method = %+v, want Service=PetService RPC=GetPet Request=GetPetRequest Response=Pet", m)
+	}
+	if m.HTTPMethod != "GET" || m.Path != "/v1/pets/{id}" {
+		t.Errorf("Parse() method = %+v, want HTTPMethod=GET Path=/v1/pets/{id}", m)
+	}
+	if m.Summary != "Get a pet" {
+		t.Errorf("Parse() method.Summary = %q, want %q", m.Summary, "Get a pet")
+	}
+	if m.Body != "" {
+		t.Errorf("Parse() method.Body = %q, want empty", m.Body)
+	}
+}
+
+func TestParseWithBody(t *testing.T) {
+	path := writeProtoFixture(t, `syntax = "proto3";
+
+service PetService {
+  rpc CreatePet(CreatePetRequest) returns (Pet) {
+    option (google.api.http) = {
+      post: "/v1/pets"
+      body: "*"
+    };
+  }
+}
+`)
+
+	methods, err := Parse(path)
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+	if len(methods) != 1 {
+		t.Fatalf("Parse() = %d methods, want 1: %+v", len(methods), methods)
+	}
+	if got := methods[0]; got.HTTPMethod != "POST" || got.Body != "*" {
+		t.Errorf("Parse() method = %+v, want HTTPMethod=POST Body=*", got)
+	}
+}
+
+func TestParseMultipleRPCsClosesEachBlock(t *testing.T) {
+	path := writeProtoFixture(t, `syntax = "proto3";
+
+service PetService {
+  rpc GetPet(GetPetRequest) returns (Pet) {
+    option (google.api.http) = {
+      get: "/v1/pets/{id}"
+    };
+  }
+
+  rpc ListPets(ListPetsRequest) returns (PetList) {
+    option (google.api.http) = {
+      get: "/v1/pets"
+    };
+  }
+}
+`)
+
+	methods, err := Parse(path)
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+	if len(methods) != 2 {
+		t.Fatalf("Parse() = %d methods, want 2: %+v", len(methods), methods)
+	}
+	if methods[0].RPC != "GetPet" || methods[1].RPC != "ListPets" {
+		t.Errorf("Parse() RPCs = [%s, %s], want [GetPet, ListPets]", methods[0].RPC, methods[1].RPC)
+	}
+}
+
+func TestParseRPCWithoutHTTPOptionIsSkipped(t *testing.T) {
+	path := writeProtoFixture(t, `syntax = "proto3";
+
+service PetService {
+  rpc Ping(PingRequest) returns (PingResponse) {}
+}
+`)
+
+	methods, err := Parse(path)
+	if err != nil {
+		t.Fatalf("Parse: %s", err)
+	}
+	if len(methods) != 0 {
+		t.Errorf("Parse() = %+v, want no methods for an rpc with no google.api.http option", methods)
+	}
+}
@@ -0,0 +1,60 @@
+// Command openapi-gen reads the swagger.json produced by the existing
+// Swagger 2.0 pipeline and writes an OpenAPI 3.x document next to it, in
+// JSON or YAML depending on the -out extension. A swag rule with a
+// format = "openapi3" attribute calling this binary as its action is future
+// Bazel plumbing this snapshot doesn't ship; run it directly in the
+// meantime, the same way as the example/, examples/http and
+// examples/gofiber mains.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/NKI-AI/rules-go-swag/openapi"
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+func main() {
+	specPath := flag.String("swagger", "docs/swagger.json", "path to the generated swagger.json")
+	outPath := flag.String("out", "docs/openapi.json", "output path; .yaml/.yml writes YAML")
+	version := flag.String("version", string(openapi.Version31), "OpenAPI version to emit: 3.0.3 or 3.1.0")
+	flag.Parse()
+
+	spec, err := swagclient.LoadSpec(*specPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "openapi-gen: %s\n", err)
+		os.Exit(1)
+	}
+
+	doc := openapi.Convert(spec, openapi.Options{Version: openapi.Version(*version)})
+
+	var data []byte
+	switch strings.ToLower(filepath.Ext(*outPath)) {
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(doc)
+	default:
+		data, err = json.MarshalIndent(doc, "", "  ")
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "openapi-gen: encode: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*outPath), 0o755); err != nil {
+		fmt.Fprintf(os.Stderr, "openapi-gen: %s\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*outPath, data, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "openapi-gen: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("openapi-gen: wrote %s (%s)\n", *outPath, doc.OpenAPI)
+}
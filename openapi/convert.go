@@ -0,0 +1,176 @@
+package openapi
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+// Version selects which OpenAPI 3.x dialect Convert emits. The two only
+// differ in their "openapi" field for the documents this package produces.
+type Version string
+
+const (
+	// Version30 emits "openapi": "3.0.3".
+	Version30 Version = "3.0.3"
+	// Version31 emits "openapi": "3.1.0".
+	Version31 Version = "3.1.0"
+)
+
+// Options controls Convert.
+type Options struct {
+	// Version defaults to Version31 when empty.
+	Version Version
+}
+
+// Convert translates a parsed swagger.json (Swagger 2.0) document into an
+// OpenAPI 3.0/3.1 Document: securityDefinitions.apikey becomes
+// components.securitySchemes, Definitions are hoisted into
+// components.schemas, and "in: body" parameters become requestBody.
+func Convert(spec *swagclient.Spec, opts Options) *Document {
+	version := opts.Version
+	if version == "" {
+		version = Version31
+	}
+
+	doc := &Document{
+		OpenAPI: string(version),
+		Info: Info{
+			Title:   spec.Info.Title,
+			Version: spec.Info.Version,
+		},
+		Paths: map[string]PathItem{},
+		Components: Components{
+			Schemas:         map[string]Schema{},
+			SecuritySchemes: map[string]SecurityScheme{},
+		},
+	}
+
+	if spec.Host != "" {
+		scheme := "http"
+		doc.Servers = []Server{{URL: fmt.Sprintf("%s://%s%s", scheme, spec.Host, spec.BasePath)}}
+	}
+
+	for name, s := range spec.Definitions {
+		doc.Components.Schemas[swagclient.ShortName(name)] = convertSchema(s)
+	}
+
+	for name, sec := range spec.SecurityDefinitions {
+		doc.Components.SecuritySchemes[name] = SecurityScheme{
+			Type: apiKeySchemeType(sec),
+			Name: sec.Name,
+			In:   sec.In,
+		}
+	}
+
+	for path, item := range spec.Paths {
+		doc.Paths[path] = convertPathItem(item)
+	}
+
+	return doc
+}
+
+// apiKeySchemeType maps swag's @securityDefinitions.apikey to the OpenAPI
+// 3.x "apiKey" scheme type; swag has no other securityDefinitions kind.
+func apiKeySchemeType(swagclient.SecurityScheme) string {
+	return "apiKey"
+}
+
+func convertPathItem(item swagclient.PathItem) PathItem {
+	var out PathItem
+	for _, pair := range item.Operations() {
+		op := convertOperation(pair.Op)
+		switch pair.Verb {
+		case "GET":
+			out.Get = op
+		case "POST":
+			out.Post = op
+		case "PUT":
+			out.Put = op
+		case "PATCH":
+			out.Patch = op
+		case "DELETE":
+			out.Delete = op
+		}
+	}
+	return out
+}
+
+func convertOperation(op *swagclient.Operation) *Operation {
+	out := &Operation{
+		OperationID: op.OperationID,
+		Tags:        op.Tags,
+		Summary:     op.Summary,
+		Description: op.Description,
+		Security:    op.Security,
+		Responses:   map[string]Response{},
+	}
+
+	for _, p := range op.Parameters {
+		if p.In == "body" {
+			schema := Schema{}
+			if p.Schema != nil {
+				schema = convertSchema(*p.Schema)
+			}
+			out.RequestBody = &RequestBody{
+				Required: p.Required,
+				Content: map[string]MediaType{
+					"application/json": {Schema: schema},
+				},
+			}
+			continue
+		}
+
+		var schema *Schema
+		if p.Type != "" {
+			s := Schema{Type: p.Type}
+			schema = &s
+		}
+		out.Parameters = append(out.Parameters, Parameter{
+			Name:     p.Name,
+			In:       p.In,
+			Required: p.Required,
+			Schema:   schema,
+		})
+	}
+
+	var codes []string
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		resp := op.Responses[code]
+		converted := Response{Description: resp.Description}
+		if resp.Schema != nil {
+			converted.Content = map[string]MediaType{
+				"application/json": {Schema: convertSchema(*resp.Schema)},
+			}
+		}
+		out.Responses[code] = converted
+	}
+
+	return out
+}
+
+func convertSchema(s swagclient.Schema) Schema {
+	if s.Ref != "" {
+		parts := strings.Split(s.Ref, "/")
+		return Schema{Ref: "#/components/schemas/" + swagclient.ShortName(parts[len(parts)-1])}
+	}
+
+	out := Schema{Type: s.Type, Required: s.Required}
+	if s.Items != nil {
+		items := convertSchema(*s.Items)
+		out.Items = &items
+	}
+	if s.Properties != nil {
+		out.Properties = map[string]Schema{}
+		for name, prop := range s.Properties {
+			out.Properties[name] = convertSchema(prop)
+		}
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+package openapi
+
+import (
+	"testing"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+func testSpec() *swagclient.Spec {
+	return &swagclient.Spec{
+		Swagger:  "2.0",
+		Host:     "localhost:8080",
+		BasePath: "/api/v1",
+		Info:     swagclient.Info{Title: "Pet Store API", Version: "1.0"},
+		SecurityDefinitions: map[string]swagclient.SecurityScheme{
+			"BearerAuth": {Type: "apiKey", Name: "Authorization", In: "header"},
+		},
+		Definitions: map[string]swagclient.Schema{
+			"main.Pet": {
+				Type:       "object",
+				Required:   []string{"name"},
+				Properties: map[string]swagclient.Schema{"name": {Type: "string"}},
+			},
+		},
+		Paths: map[string]swagclient.PathItem{
+			"/pets": {
+				Post: &swagclient.Operation{
+					OperationID: "createPet",
+					Security:    []map[string][]string{{"BearerAuth": {}}},
+					Parameters: []swagclient.Parameter{
+						{Name: "pet", In: "body", Required: true, Schema: &swagclient.Schema{Ref: "#/definitions/main.Pet"}},
+					},
+					Responses: map[string]swagclient.Response{
+						"201": {Description: "created", Schema: &swagclient.Schema{Ref: "#/definitions/main.Pet"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestConvertHoistsDefinitions(t *testing.T) {
+	doc := Convert(testSpec(), Options{})
+
+	if _, ok := doc.Components.Schemas["Pet"]; !ok {
+		t.Fatalf("Components.Schemas missing %q, got %v", "Pet", doc.Components.Schemas)
+	}
+}
+
+func TestConvertSecurityDefinitions(t *testing.T) {
+	doc := Convert(testSpec(), Options{})
+
+	scheme, ok := doc.Components.SecuritySchemes["BearerAuth"]
+	if !ok {
+		t.Fatal("Components.SecuritySchemes missing BearerAuth")
+	}
+	if scheme.Type != "apiKey" {
+		t.Errorf("SecuritySchemes[BearerAuth].Type = %q, want %q", scheme.Type, "apiKey")
+	}
+}
+
+func TestConvertBodyParamToRequestBody(t *testing.T) {
+	doc := Convert(testSpec(), Options{})
+
+	op := doc.Paths["/pets"].Post
+	if op == nil {
+		t.Fatal("Paths[/pets].Post is nil")
+	}
+	if op.RequestBody == nil {
+		t.Fatal("RequestBody is nil, want the body param hoisted into it")
+	}
+	media, ok := op.RequestBody.Content["application/json"]
+	if !ok {
+		t.Fatal("RequestBody.Content missing application/json")
+	}
+	if media.Schema.Ref != "#/components/schemas/Pet" {
+		t.Errorf("RequestBody schema ref = %q, want %q", media.Schema.Ref, "#/components/schemas/Pet")
+	}
+	if len(op.Parameters) != 0 {
+		t.Errorf("Parameters = %v, want the body param removed", op.Parameters)
+	}
+}
+
+func TestConvertVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		want string
+	}{
+		{"defaults to 3.1", Options{}, string(Version31)},
+		{"explicit 3.0", Options{Version: Version30}, string(Version30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := Convert(testSpec(), tt.opts)
+			if doc.OpenAPI != tt.want {
+				t.Errorf("OpenAPI = %q, want %q", doc.OpenAPI, tt.want)
+			}
+		})
+	}
+}
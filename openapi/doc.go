@@ -0,0 +1,15 @@
+// Package openapi converts the Swagger 2.0 documents produced for this
+// repository's examples into OpenAPI 3.0/3.1, via cmd/openapi-gen, so
+// downstream consumers can get openapi.json/openapi.yaml alongside today's
+// swagger.json/yaml. This snapshot has no Bazel workspace, so there is no
+// swag rule wired to call it yet; openapi-gen is run directly the same way
+// as the example/, examples/http and examples/gofiber mains.
+//
+// The conversion is structural, not semantic: @securityDefinitions.apikey
+// blocks become components.securitySchemes entries, reused schemas such as
+// Pet, Todo and ErrorResponse are hoisted into components.schemas, and
+// @Param body parameters become requestBody. This lets downstream 3.x
+// tooling (kin-openapi validators, oapi-codegen clients) consume the same
+// annotations that already document example/, examples/http,
+// examples/gofiber and example/fiber.
+package openapi
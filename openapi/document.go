@@ -0,0 +1,99 @@
+package openapi
+
+// Document is the subset of the OpenAPI 3.0/3.1 object model this package
+// writes out. Both versions share this shape closely enough that a single
+// struct covers them; Convert sets the OpenAPI field to select which.
+type Document struct {
+	OpenAPI    string              `json:"openapi" yaml:"openapi"`
+	Info       Info                `json:"info" yaml:"info"`
+	Servers    []Server            `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Paths      map[string]PathItem `json:"paths" yaml:"paths"`
+	Components Components          `json:"components" yaml:"components"`
+}
+
+// Info mirrors the @title/@version/@description annotations.
+type Info struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// Server replaces Swagger 2.0's separate host/basePath fields with a single
+// base URL, as required by OpenAPI 3.x.
+type Server struct {
+	URL string `json:"url" yaml:"url"`
+}
+
+// PathItem groups the operations declared on a single @Router path.
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty" yaml:"get,omitempty"`
+	Post   *Operation `json:"post,omitempty" yaml:"post,omitempty"`
+	Put    *Operation `json:"put,omitempty" yaml:"put,omitempty"`
+	Patch  *Operation `json:"patch,omitempty" yaml:"patch,omitempty"`
+	Delete *Operation `json:"delete,omitempty" yaml:"delete,omitempty"`
+}
+
+// Operation corresponds to one @Summary/@Param/@Success annotation block,
+// translated into OpenAPI 3.x shape: body parameters move to RequestBody.
+type Operation struct {
+	OperationID string                `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Tags        []string              `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string                `json:"description,omitempty" yaml:"description,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]Response   `json:"responses" yaml:"responses"`
+	Security    []map[string][]string `json:"security,omitempty" yaml:"security,omitempty"`
+}
+
+// Parameter models a path/query/header @Param entry; body parameters are
+// represented as RequestBody instead, per the OpenAPI 3.x spec.
+type Parameter struct {
+	Name     string  `json:"name" yaml:"name"`
+	In       string  `json:"in" yaml:"in"`
+	Required bool    `json:"required,omitempty" yaml:"required,omitempty"`
+	Schema   *Schema `json:"schema,omitempty" yaml:"schema,omitempty"`
+}
+
+// RequestBody replaces a Swagger 2.0 "in: body" parameter. Content maps a
+// media type to its schema; Convert fills it with a single
+// "application/json" entry and does not read the operation's @Accept types.
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty" yaml:"required,omitempty"`
+	Content  map[string]MediaType `json:"content" yaml:"content"`
+}
+
+// MediaType pairs a content type with the schema describing its body.
+type MediaType struct {
+	Schema Schema `json:"schema" yaml:"schema"`
+}
+
+// Response models a single @Success/@Failure entry; Convert moves its schema
+// under "application/json" regardless of the operation's @Produce types.
+type Response struct {
+	Description string               `json:"description" yaml:"description"`
+	Content     map[string]MediaType `json:"content,omitempty" yaml:"content,omitempty"`
+}
+
+// Schema is an inline type, an array, or a $ref into components.schemas.
+type Schema struct {
+	Ref        string            `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+	Type       string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Items      *Schema           `json:"items,omitempty" yaml:"items,omitempty"`
+	Properties map[string]Schema `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Required   []string          `json:"required,omitempty" yaml:"required,omitempty"`
+}
+
+// Components hoists the reused schemas and security schemes that Swagger
+// 2.0 keeps at the document root.
+type Components struct {
+	Schemas         map[string]Schema         `json:"schemas,omitempty" yaml:"schemas,omitempty"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty" yaml:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme is the 3.x translation of a @securityDefinitions.apikey
+// block such as the BearerAuth scheme used throughout the examples.
+type SecurityScheme struct {
+	Type string `json:"type" yaml:"type"`
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+	In   string `json:"in,omitempty" yaml:"in,omitempty"`
+}
@@ -0,0 +1,41 @@
+// Command swagclient reads a generated swagger.json and writes a typed
+// client SDK next to it. This repository ships no Bazel workspace, so it is
+// run directly (go run ./swagclient/cmd/swagclient ...) the same way the
+// example/, examples/http and examples/gofiber mains are; a swag_client
+// Bazel rule wrapping this binary as its action is future work, not
+// something this snapshot provides.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+func main() {
+	specPath := flag.String("swagger", "docs/swagger.json", "path to the generated swagger.json")
+	outDir := flag.String("out", "client", "output directory for the generated SDK")
+	pkg := flag.String("package", "client", "Go package name for the generated SDK")
+	lang := flag.String("lang", "go", "output language: go or typescript")
+	flag.Parse()
+
+	cfg := swagclient.Config{
+		Package: *pkg,
+		Lang:    swagclient.Lang(*lang),
+	}
+
+	client, err := swagclient.Generate(*specPath, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "swagclient: %s\n", err)
+		os.Exit(1)
+	}
+
+	if err := swagclient.GenerateFiles(client, cfg, *outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "swagclient: %s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("swagclient: wrote %d method(s) to %s\n", len(client.Methods), *outDir)
+}
@@ -0,0 +1,8 @@
+// Package swagclient generates typed Go (and optionally TypeScript) client
+// SDKs from a swagger.json document produced by the generate_docs pipeline.
+//
+// Where swag walks annotated handlers to build a swagger.json, swagclient
+// walks that swagger.json to build a Client with one method per operation,
+// so the REST APIs documented by the examples in this repository can be
+// consumed without hand-written HTTP plumbing.
+package swagclient
@@ -0,0 +1,164 @@
+package swagclient
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testSwagger = `{
+  "swagger": "2.0",
+  "host": "localhost:8080",
+  "basePath": "/api/v1",
+  "info": {"title": "Pet Store API", "version": "1.0"},
+  "securityDefinitions": {"BearerAuth": {"type": "apiKey", "name": "Authorization", "in": "header"}},
+  "paths": {
+    "/pets": {
+      "get": {
+        "operationId": "listPets",
+        "summary": "List pets",
+        "responses": {"200": {"description": "ok", "schema": {"type": "array", "items": {"$ref": "#/definitions/main.Pet"}}}}
+      },
+      "post": {
+        "operationId": "createPet",
+        "summary": "Create pet",
+        "security": [{"BearerAuth": []}],
+        "parameters": [{"name": "pet", "in": "body", "schema": {"$ref": "#/definitions/main.Pet"}}],
+        "responses": {"201": {"description": "created", "schema": {"$ref": "#/definitions/main.Pet"}}}
+      }
+    }
+  },
+  "definitions": {
+    "main.Pet": {"type": "object", "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}}
+  }
+}`
+
+func writeTestSpec(t *testing.T, name, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(contents), 0o644); err != nil {
+		t.Fatalf("write fixture: %s", err)
+	}
+	return path
+}
+
+func TestGenerate(t *testing.T) {
+	path := writeTestSpec(t, "swagger.json", testSwagger)
+
+	c, err := Generate(path, Config{Package: "client"})
+	if err != nil {
+		t.Fatalf("Generate: %s", err)
+	}
+
+	if len(c.Methods) != 2 {
+		t.Fatalf("got %d methods, want 2", len(c.Methods))
+	}
+
+	byName := map[string]Method{}
+	for _, m := range c.Methods {
+		byName[m.Name] = m
+	}
+
+	list, ok := byName["ListPets"]
+	if !ok {
+		t.Fatalf("missing ListPets method, got %+v", byName)
+	}
+	if list.Secured {
+		t.Errorf("ListPets: Secured = true, want false (no @Security declared)")
+	}
+	if !list.HasResponse() {
+		t.Errorf("ListPets: HasResponse() = false, want true")
+	}
+
+	create, ok := byName["CreatePet"]
+	if !ok {
+		t.Fatalf("missing CreatePet method, got %+v", byName)
+	}
+	if !create.Secured {
+		t.Errorf("CreatePet: Secured = false, want true (@Security BearerAuth declared)")
+	}
+	if !create.HasRequest() {
+		t.Errorf("CreatePet: HasRequest() = false, want true")
+	}
+	if create.RequestGoType != "Pet" {
+		t.Errorf("CreatePet: RequestGoType = %q, want %q", create.RequestGoType, "Pet")
+	}
+}
+
+func TestGenerateYAMLSpec(t *testing.T) {
+	yamlSpec := `
+swagger: "2.0"
+basePath: /api/v1
+info:
+  title: Pet Store API
+  version: "1.0"
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      responses:
+        "200":
+          description: ok
+          schema:
+            $ref: "#/definitions/main.Pet"
+definitions:
+  main.Pet:
+    type: object
+    properties:
+      id:
+        type: integer
+`
+	path := writeTestSpec(t, "swagger.yaml", yamlSpec)
+
+	c, err := Generate(path, Config{Package: "client"})
+	if err != nil {
+		t.Fatalf("Generate: %s", err)
+	}
+	if len(c.Methods) != 1 || c.Methods[0].Name != "ListPets" {
+		t.Fatalf("got methods %+v, want a single ListPets method", c.Methods)
+	}
+}
+
+func TestMethodNameFallback(t *testing.T) {
+	tests := []struct {
+		name string
+		op   *Operation
+		verb string
+		path string
+		want string
+	}{
+		{"operationId", &Operation{OperationID: "getPet"}, "GET", "/pets/{id}", "GetPet"},
+		{"tags+summary", &Operation{Tags: []string{"pets"}, Summary: "Get a pet"}, "GET", "/pets/{id}", "PetsGetAPet"},
+		{"verb+path", &Operation{}, "GET", "/pets/{id}", "GETPetsId"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := methodName(tt.op, tt.verb, tt.path); got != tt.want {
+				t.Errorf("methodName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateFilesGo(t *testing.T) {
+	path := writeTestSpec(t, "swagger.json", testSwagger)
+	cfg := Config{Package: "client", Lang: LangGo}
+
+	c, err := Generate(path, cfg)
+	if err != nil {
+		t.Fatalf("Generate: %s", err)
+	}
+
+	outDir := t.TempDir()
+	if err := GenerateFiles(c, cfg, outDir); err != nil {
+		t.Fatalf("GenerateFiles: %s", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(outDir, "client.gen.go"))
+	if err != nil {
+		t.Fatalf("read generated file: %s", err)
+	}
+	if len(data) == 0 {
+		t.Fatal("generated Go file is empty")
+	}
+}
@@ -0,0 +1,103 @@
+package swagclient
+
+// The types below model the subset of the Swagger 2.0 schema that the
+// generator needs. They are intentionally narrower than a full swagger.json
+// parser: fields they do not model are simply ignored when LoadSpec decodes
+// a JSON or YAML document.
+
+// Spec is the root of a swagger.json document.
+type Spec struct {
+	Swagger             string                    `json:"swagger" yaml:"swagger"`
+	Info                Info                      `json:"info" yaml:"info"`
+	Host                string                    `json:"host" yaml:"host"`
+	BasePath            string                    `json:"basePath" yaml:"basePath"`
+	Paths               map[string]PathItem       `json:"paths" yaml:"paths"`
+	Definitions         map[string]Schema         `json:"definitions" yaml:"definitions"`
+	SecurityDefinitions map[string]SecurityScheme `json:"securityDefinitions" yaml:"securityDefinitions"`
+}
+
+// Info carries the API-level metadata declared via @title/@version/etc.
+type Info struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// PathItem groups the operations declared on a single @Router path.
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty" yaml:"get,omitempty"`
+	Post   *Operation `json:"post,omitempty" yaml:"post,omitempty"`
+	Put    *Operation `json:"put,omitempty" yaml:"put,omitempty"`
+	Patch  *Operation `json:"patch,omitempty" yaml:"patch,omitempty"`
+	Delete *Operation `json:"delete,omitempty" yaml:"delete,omitempty"`
+}
+
+// Operations returns the declared (verb, operation) pairs for a path, in a
+// stable order so generated client code doesn't churn between runs.
+func (p PathItem) Operations() []struct {
+	Verb string
+	Op   *Operation
+} {
+	var ops []struct {
+		Verb string
+		Op   *Operation
+	}
+	for _, pair := range []struct {
+		Verb string
+		Op   *Operation
+	}{
+		{"GET", p.Get},
+		{"POST", p.Post},
+		{"PUT", p.Put},
+		{"PATCH", p.Patch},
+		{"DELETE", p.Delete},
+	} {
+		if pair.Op != nil {
+			ops = append(ops, pair)
+		}
+	}
+	return ops
+}
+
+// Operation corresponds to one @Summary/@Param/@Success annotation block.
+type Operation struct {
+	OperationID string                `json:"operationId" yaml:"operationId"`
+	Tags        []string              `json:"tags" yaml:"tags"`
+	Summary     string                `json:"summary" yaml:"summary"`
+	Description string                `json:"description" yaml:"description"`
+	Parameters  []Parameter           `json:"parameters" yaml:"parameters"`
+	Responses   map[string]Response   `json:"responses" yaml:"responses"`
+	Security    []map[string][]string `json:"security" yaml:"security"`
+}
+
+// Parameter models a single @Param entry: path, query, header or body.
+type Parameter struct {
+	Name     string  `json:"name" yaml:"name"`
+	In       string  `json:"in" yaml:"in"`
+	Required bool    `json:"required" yaml:"required"`
+	Type     string  `json:"type" yaml:"type"`
+	Schema   *Schema `json:"schema" yaml:"schema"`
+}
+
+// Response models a single @Success/@Failure entry.
+type Response struct {
+	Description string  `json:"description" yaml:"description"`
+	Schema      *Schema `json:"schema" yaml:"schema"`
+}
+
+// Schema is either a $ref to a Definitions entry or an inline primitive/array
+// type, mirroring what swag emits for {object} and {array} success types.
+type Schema struct {
+	Ref        string            `json:"$ref" yaml:"$ref"`
+	Type       string            `json:"type" yaml:"type"`
+	Items      *Schema           `json:"items" yaml:"items"`
+	Properties map[string]Schema `json:"properties" yaml:"properties"`
+	Required   []string          `json:"required" yaml:"required"`
+}
+
+// SecurityScheme models a @securityDefinitions.apikey block such as the
+// BearerAuth scheme used throughout the examples.
+type SecurityScheme struct {
+	Type string `json:"type" yaml:"type"`
+	Name string `json:"name" yaml:"name"`
+	In   string `json:"in" yaml:"in"`
+}
@@ -0,0 +1,137 @@
+package swagclient
+
+import "text/template"
+
+// goClientTemplate renders a Client struct with pluggable http.RoundTripper
+// and base URL, plus one method per documented operation. The generated file
+// is self-contained and only depends on bytes, encoding/json, fmt, net/http
+// and strings.
+var goClientTemplate = template.Must(template.New("go-client").Parse(`// Code generated by swagclient. DO NOT EDIT.
+
+package {{.PackageName}}
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+{{range .Types}}
+// {{.Name}} mirrors the "{{.Name}}" schema in swagger.json.
+type {{.Name}} struct {
+{{range .Fields}}	{{.GoName}} {{.GoType}} ` + "`json:\"{{.JSONName}}\"`" + `
+{{end}}}
+{{end}}
+// Client calls the API documented at {{.BasePath}}.
+type Client struct {
+	BaseURL    string
+	AuthToken  string
+	Transport  http.RoundTripper
+}
+
+// NewClient returns a Client that talks to baseURL using http.DefaultTransport
+// unless overridden via Client.Transport.
+func NewClient(baseURL string) *Client {
+	return &Client{BaseURL: baseURL, Transport: http.DefaultTransport}
+}
+
+func (c *Client) httpClient() *http.Client {
+	return &http.Client{Transport: c.Transport}
+}
+
+func (c *Client) do(method, path string, body interface{}, out interface{}, secured bool) error {
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, strings.TrimRight(c.BaseURL, "/")+path, reader)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if secured && c.AuthToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.AuthToken)
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("%s %s: unexpected status %d", method, path, resp.StatusCode)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+{{range .Methods}}
+// {{.Name}} calls {{.Verb}} {{.Path}}.
+{{if .Summary}}// {{.Summary}}
+{{end}}func (c *Client) {{.Name}}({{template "params" .}}) {{template "returns" .}} {
+	path := {{printf "%q" .Path}}
+{{range .PathParams}}	path = strings.Replace(path, "{{"{"}}{{.}}{{"}"}}", fmt.Sprint({{.}}), 1)
+{{end}}{{if .HasResponse}}	var out {{.ResponseGoType}}
+	err := c.do({{printf "%q" .Verb}}, path, {{if .HasRequest}}body{{else}}nil{{end}}, &out, {{.Secured}})
+	return out, err
+{{else}}	return c.do({{printf "%q" .Verb}}, path, {{if .HasRequest}}body{{else}}nil{{end}}, nil, {{.Secured}})
+{{end}}}
+{{end}}
+{{define "params"}}{{range $i, $p := .PathParams}}{{if $i}}, {{end}}{{$p}} string{{end}}{{if .HasRequest}}{{if .PathParams}}, {{end}}body {{.RequestGoType}}{{end}}{{end}}
+{{define "returns"}}{{if .HasResponse}}({{.ResponseGoType}}, error){{else}}error{{end}}{{end}}
+`))
+
+// tsClientTemplate renders the optional TypeScript counterpart using fetch,
+// mirroring the Go client's shape (one method per operation, bearer auth).
+var tsClientTemplate = template.Must(template.New("ts-client").Parse(`// Code generated by swagclient. DO NOT EDIT.
+{{range .Types}}
+export interface {{.Name}} {
+{{range .Fields}}	{{.TSName}}: {{.TSType}};
+{{end}}}
+{{end}}
+export class Client {
+	constructor(private baseUrl: string, private authToken?: string) {}
+
+	private async request<T>(method: string, path: string, body?: unknown, secured?: boolean): Promise<T> {
+		const headers: Record<string, string> = {};
+		if (body !== undefined) {
+			headers["Content-Type"] = "application/json";
+		}
+		if (secured && this.authToken) {
+			headers["Authorization"] = ` + "`Bearer ${this.authToken}`" + `;
+		}
+		const res = await fetch(this.baseUrl.replace(/\/$/, "") + path, {
+			method,
+			headers,
+			body: body !== undefined ? JSON.stringify(body) : undefined,
+		});
+		if (!res.ok) {
+			throw new Error(` + "`${method} ${path}: unexpected status ${res.status}`" + `);
+		}
+		if (res.status === 204) {
+			return undefined as unknown as T;
+		}
+		return res.json() as Promise<T>;
+	}
+{{range .Methods}}
+	{{template "tsMethod" .}}
+{{end}}}
+{{define "tsMethod"}}async {{.Name}}({{range $i, $p := .PathParams}}{{if $i}}, {{end}}{{$p}}: string{{end}}{{if .HasRequest}}{{if .PathParams}}, {{end}}body: {{.RequestTSType}}{{end}}){{if .HasResponse}}: Promise<{{.ResponseTSType}}>{{else}}: Promise<void>{{end}} {
+		let path = {{printf "%q" .Path}};
+{{range .PathParams}}		path = path.replace("{{"{"}}{{.}}{{"}"}}", {{.}});
+{{end}}		return this.request({{printf "%q" .Verb}}, path, {{if .HasRequest}}body{{else}}undefined{{end}}, {{.Secured}});
+	}
+{{end}}
+`))
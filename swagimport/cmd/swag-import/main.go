@@ -0,0 +1,73 @@
+// Command swag-import, in its default mode, reads an existing
+// OpenAPI/Swagger file (JSON or YAML) and writes Go handler stubs with
+// matching annotation blocks. With -verify it instead diffs an
+// already-annotated Go file against the spec and exits non-zero on drift.
+// A swag_import Bazel rule/test wrapping this binary is future Bazel
+// plumbing this snapshot doesn't ship; run it directly in the meantime,
+// the same way as the example/, examples/http and examples/gofiber mains.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+	"github.com/NKI-AI/rules-go-swag/swagimport"
+)
+
+func main() {
+	specPath := flag.String("swagger", "docs/swagger.json", "path to the canonical OpenAPI/Swagger file")
+	outPath := flag.String("out", "", "path to write generated handler stubs (default mode)")
+	pkg := flag.String("package", "main", "package name for generated stubs")
+	verifyFile := flag.String("verify", "", "path to an annotated Go file to diff against -swagger instead of generating")
+	flag.Parse()
+
+	if *verifyFile != "" {
+		runVerify(*specPath, *verifyFile)
+		return
+	}
+	runGenerate(*specPath, *outPath, *pkg)
+}
+
+func runGenerate(specPath, outPath, pkg string) {
+	spec, err := swagclient.LoadSpec(specPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "swag-import: %s\n", err)
+		os.Exit(1)
+	}
+
+	src, err := swagimport.GenerateStubs(spec, swagimport.Options{Package: pkg})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "swag-import: %s\n", err)
+		os.Exit(1)
+	}
+
+	if outPath == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(outPath, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "swag-import: %s\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("swag-import: wrote %s\n", outPath)
+}
+
+func runVerify(specPath, goFile string) {
+	drifts, err := swagimport.Verify(specPath, goFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "swag-import: %s\n", err)
+		os.Exit(1)
+	}
+	if len(drifts) == 0 {
+		fmt.Printf("swag-import: %s matches %s\n", goFile, specPath)
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "swag-import: %s has drifted from %s:\n", goFile, specPath)
+	for _, d := range drifts {
+		fmt.Fprintf(os.Stderr, "  %s\n", d)
+	}
+	os.Exit(1)
+}
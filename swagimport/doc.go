@@ -0,0 +1,7 @@
+// Package swagimport supports a spec-first workflow that mirrors the
+// code-first annotations used throughout example/, examples/http,
+// examples/gofiber and example/fiber: given an existing swagger.json, it
+// generates Go handler stubs carrying matching // @Summary/@Param/@Success
+// blocks, and can verify that handwritten annotations in an existing file
+// still agree with a canonical spec.
+package swagimport
@@ -0,0 +1,167 @@
+package swagimport
+
+import (
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+// Options controls GenerateStubs.
+type Options struct {
+	// Package is the generated file's package name. Defaults to "main",
+	// matching the example/, examples/http and examples/gofiber mains.
+	Package string
+}
+
+// GenerateStubs renders one Go handler stub, with its @Summary/@Param/
+// @Success annotation block, per operation in spec -- the spec-first
+// counterpart to the code-first handlers in example/, examples/http,
+// examples/gofiber and example/fiber.
+func GenerateStubs(spec *swagclient.Spec, opts Options) ([]byte, error) {
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = "main"
+	}
+
+	var paths []string
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "// Code generated by swag_import. DO NOT EDIT.\n\npackage %s\n\nimport \"net/http\"\n", pkg)
+
+	for _, path := range paths {
+		item := spec.Paths[path]
+		for _, pair := range item.Operations() {
+			b.WriteString(renderStub(pair.Verb, path, pair.Op))
+		}
+	}
+
+	return format.Source([]byte(b.String()))
+}
+
+func renderStub(verb, path string, op *swagclient.Operation) string {
+	name := stubName(op, verb, path)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "\n// %s godoc\n", name)
+	if op.Summary != "" {
+		fmt.Fprintf(&b, "// @Summary      %s\n", op.Summary)
+	}
+	if op.Description != "" {
+		fmt.Fprintf(&b, "// @Description  %s\n", op.Description)
+	}
+	if len(op.Tags) > 0 {
+		fmt.Fprintf(&b, "// @Tags         %s\n", strings.Join(op.Tags, ","))
+	}
+	b.WriteString("// @Accept       json\n")
+	b.WriteString("// @Produce      json\n")
+
+	for _, p := range op.Parameters {
+		if p.In == "body" {
+			fmt.Fprintf(&b, "// @Param        body  body      %s  true  \"request body\"\n", schemaTypeName(p.Schema))
+			continue
+		}
+		fmt.Fprintf(&b, "// @Param        %s   %s      %s  %t  \"%s\"\n", p.Name, p.In, paramType(p), p.Required, p.Name)
+	}
+
+	var codes []string
+	for code := range op.Responses {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		resp := op.Responses[code]
+		if resp.Schema == nil {
+			continue
+		}
+		schema := resp.Schema
+		if schema.Type == "array" && schema.Items != nil {
+			schema = schema.Items
+		}
+		fmt.Fprintf(&b, "// @Success      %s  %s  %s\n", code, schemaKind(resp.Schema), schemaTypeName(schema))
+	}
+
+	for _, sec := range op.Security {
+		for name := range sec {
+			fmt.Fprintf(&b, "// @Security     %s\n", name)
+		}
+	}
+
+	fmt.Fprintf(&b, "// @Router       %s [%s]\n", path, strings.ToLower(verb))
+	fmt.Fprintf(&b, "func %s(w http.ResponseWriter, r *http.Request) {\n", name)
+	fmt.Fprintf(&b, "\t// TODO: implement %s; see %s %s in the spec for the documented contract.\n", name, verb, path)
+	b.WriteString("\thttp.Error(w, \"not implemented\", http.StatusNotImplemented)\n")
+	b.WriteString("}\n")
+
+	return b.String()
+}
+
+// stubName picks a handler name the way listTodos/getPet/createPet are
+// named in the examples: the operationId verbatim, Tags[0]+Summary, or
+// Verb+Path, lower-camelled.
+func stubName(op *swagclient.Operation, verb, path string) string {
+	raw := op.OperationID
+	if raw == "" && len(op.Tags) > 0 && op.Summary != "" {
+		raw = op.Tags[0] + " " + op.Summary
+	}
+	if raw == "" {
+		raw = verb + " " + path
+	}
+	return lowerCamel(raw)
+}
+
+func lowerCamel(s string) string {
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	var b strings.Builder
+	for i, f := range fields {
+		if i == 0 {
+			b.WriteString(strings.ToLower(f[:1]))
+			b.WriteString(f[1:])
+			continue
+		}
+		b.WriteString(strings.ToUpper(f[:1]))
+		b.WriteString(f[1:])
+	}
+	if b.Len() == 0 {
+		return "operation"
+	}
+	return b.String()
+}
+
+func paramType(p swagclient.Parameter) string {
+	if p.Type == "" {
+		return "string"
+	}
+	return p.Type
+}
+
+// schemaKind picks the swag response tag ({array} vs {object}) matching
+// the style used on listPets/listTodos ({array}) versus getPet ({object}).
+func schemaKind(s *swagclient.Schema) string {
+	if s != nil && s.Type == "array" {
+		return "{array}"
+	}
+	return "{object}"
+}
+
+func schemaTypeName(s *swagclient.Schema) string {
+	if s == nil {
+		return ""
+	}
+	if s.Ref != "" {
+		parts := strings.Split(s.Ref, "/")
+		return swagclient.ShortName(parts[len(parts)-1])
+	}
+	if s.Type == "array" && s.Items != nil {
+		return "[]" + schemaTypeName(s.Items)
+	}
+	return "object"
+}
@@ -0,0 +1,103 @@
+package swagimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+func testSpec() *swagclient.Spec {
+	return &swagclient.Spec{
+		BasePath: "/api/v1",
+		Paths: map[string]swagclient.PathItem{
+			"/pets/{id}": {
+				Get: &swagclient.Operation{
+					OperationID: "getPet",
+					Summary:     "Get a pet",
+					Parameters: []swagclient.Parameter{
+						{Name: "id", In: "path", Type: "integer", Required: true},
+					},
+					Responses: map[string]swagclient.Response{
+						"200": {Schema: &swagclient.Schema{Ref: "#/definitions/main.Pet"}},
+					},
+				},
+			},
+			"/pets": {
+				Post: &swagclient.Operation{
+					OperationID: "createPet",
+					Summary:     "Create a pet",
+					Security:    []map[string][]string{{"BearerAuth": {}}},
+					Parameters: []swagclient.Parameter{
+						{Name: "pet", In: "body", Schema: &swagclient.Schema{Ref: "#/definitions/main.Pet"}},
+					},
+					Responses: map[string]swagclient.Response{
+						"201": {Schema: &swagclient.Schema{Ref: "#/definitions/main.Pet"}},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGenerateStubs(t *testing.T) {
+	src, err := GenerateStubs(testSpec(), Options{Package: "main"})
+	if err != nil {
+		t.Fatalf("GenerateStubs: %s", err)
+	}
+	out := string(src)
+
+	for _, want := range []string{
+		"func getPet(w http.ResponseWriter, r *http.Request)",
+		"func createPet(w http.ResponseWriter, r *http.Request)",
+		`@Param        id   path      integer  true  "id"`,
+		`@Param        body  body      Pet  true  "request body"`,
+		"@Security     BearerAuth",
+		"@Router       /pets/{id} [get]",
+		"@Router       /pets [post]",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated stubs missing %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestStubNameFallback(t *testing.T) {
+	tests := []struct {
+		name string
+		op   *swagclient.Operation
+		verb string
+		path string
+		want string
+	}{
+		{"operationId", &swagclient.Operation{OperationID: "getPet"}, "GET", "/pets/{id}", "getPet"},
+		{"tags+summary", &swagclient.Operation{Tags: []string{"pets"}, Summary: "Get a pet"}, "GET", "/pets/{id}", "petsGetAPet"},
+		{"verb+path", &swagclient.Operation{}, "GET", "/pets/{id}", "gETPetsId"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stubName(tt.op, tt.verb, tt.path); got != tt.want {
+				t.Errorf("stubName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemaKind(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *swagclient.Schema
+		want string
+	}{
+		{"array", &swagclient.Schema{Type: "array"}, "{array}"},
+		{"object", &swagclient.Schema{Type: "object"}, "{object}"},
+		{"nil", nil, "{object}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := schemaKind(tt.s); got != tt.want {
+				t.Errorf("schemaKind() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package swagimport
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+// Drift is one handwritten annotation that no longer agrees with the
+// canonical spec, surfaced by Verify so a CI check (a Bazel test, once this
+// repo has a workspace, or a plain `go run ... -verify` step today) can fail
+// the build when handlers drift from swagger.json.
+type Drift struct {
+	FuncName string
+	Field    string
+	Want     string
+	Got      string
+}
+
+func (d Drift) String() string {
+	return fmt.Sprintf("%s: %s mismatch: want %q, got %q", d.FuncName, d.Field, d.Want, d.Got)
+}
+
+type annotation struct {
+	verb      string
+	router    string
+	summary   string
+	params    []paramAnno
+	responses map[string]responseAnno
+}
+
+// paramAnno is one parsed @Param line.
+type paramAnno struct {
+	name     string
+	in       string
+	typ      string
+	required bool
+}
+
+// responseAnno is one parsed @Success/@Failure line, keyed by status code.
+type responseAnno struct {
+	kind string // {object} or {array}
+	typ  string
+}
+
+var (
+	routerRe   = regexp.MustCompile(`^@Router\s+(\S+)\s+\[(\w+)\]`)
+	summaryRe  = regexp.MustCompile(`^@Summary\s+(.*)$`)
+	paramRe    = regexp.MustCompile(`^@Param\s+(\S+)\s+(\S+)\s+(\S+)\s+(true|false)\s+"[^"]*"`)
+	responseRe = regexp.MustCompile(`^@(?:Success|Failure)\s+(\d+)\s+(\{[^}]+\})\s+(\S+)`)
+)
+
+// Verify parses the annotated handlers in goFile and compares their
+// @Router, @Summary, @Param and @Success/@Failure annotations against the
+// canonical spec at specPath, returning
+// one Drift per mismatch. A nil/empty result means the annotations and the
+// spec agree.
+func Verify(specPath, goFile string) ([]Drift, error) {
+	spec, err := swagclient.LoadSpec(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	annotated, err := parseAnnotations(goFile)
+	if err != nil {
+		return nil, err
+	}
+
+	canonical := map[string]annotation{}
+	for path, item := range spec.Paths {
+		for _, pair := range item.Operations() {
+			canonical[routeKey(pair.Verb, path)] = annotation{
+				verb:      strings.ToLower(pair.Verb),
+				router:    path,
+				summary:   pair.Op.Summary,
+				params:    canonicalParams(pair.Op),
+				responses: canonicalResponses(pair.Op),
+			}
+		}
+	}
+
+	var drifts []Drift
+	for funcName, got := range annotated {
+		key := routeKey(strings.ToUpper(got.verb), got.router)
+		want, ok := canonical[key]
+		if !ok {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Router", Want: "<no matching operation in spec>", Got: key})
+			continue
+		}
+		if got.summary != want.summary {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Summary", Want: want.summary, Got: got.summary})
+		}
+		drifts = append(drifts, diffParams(funcName, want.params, got.params)...)
+		drifts = append(drifts, diffResponses(funcName, want.responses, got.responses)...)
+	}
+
+	sort.Slice(drifts, func(i, j int) bool { return drifts[i].FuncName < drifts[j].FuncName })
+	return drifts, nil
+}
+
+// canonicalParams mirrors renderStub's @Param rendering so Verify flags the
+// same drift a regeneration would silently fix.
+func canonicalParams(op *swagclient.Operation) []paramAnno {
+	var params []paramAnno
+	for _, p := range op.Parameters {
+		if p.In == "body" {
+			params = append(params, paramAnno{name: "body", in: "body", typ: schemaTypeName(p.Schema), required: true})
+			continue
+		}
+		params = append(params, paramAnno{name: p.Name, in: p.In, typ: paramType(p), required: p.Required})
+	}
+	return params
+}
+
+// canonicalResponses mirrors renderStub's @Success rendering, including its
+// array-unwrapping for the type name.
+func canonicalResponses(op *swagclient.Operation) map[string]responseAnno {
+	responses := map[string]responseAnno{}
+	for code, resp := range op.Responses {
+		if resp.Schema == nil {
+			continue
+		}
+		schema := resp.Schema
+		if schema.Type == "array" && schema.Items != nil {
+			schema = schema.Items
+		}
+		responses[code] = responseAnno{kind: schemaKind(resp.Schema), typ: schemaTypeName(schema)}
+	}
+	return responses
+}
+
+// paramKey identifies a parameter independently of declaration order.
+func paramKey(p paramAnno) string { return p.in + " " + p.name }
+
+// diffParams reports every @Param that the spec and the handwritten
+// annotations disagree about, in either direction.
+func diffParams(funcName string, want, got []paramAnno) []Drift {
+	wantByKey := map[string]paramAnno{}
+	for _, p := range want {
+		wantByKey[paramKey(p)] = p
+	}
+	gotByKey := map[string]paramAnno{}
+	for _, p := range got {
+		gotByKey[paramKey(p)] = p
+	}
+
+	var drifts []Drift
+	for key, w := range wantByKey {
+		g, ok := gotByKey[key]
+		if !ok {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Param " + key, Want: fmt.Sprintf("%s required=%t", w.typ, w.required), Got: "<missing>"})
+			continue
+		}
+		if g.typ != w.typ || g.required != w.required {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Param " + key, Want: fmt.Sprintf("%s required=%t", w.typ, w.required), Got: fmt.Sprintf("%s required=%t", g.typ, g.required)})
+		}
+	}
+	for key := range gotByKey {
+		if _, ok := wantByKey[key]; !ok {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Param " + key, Want: "<no longer in spec>", Got: gotByKey[key].typ})
+		}
+	}
+	return drifts
+}
+
+// diffResponses reports every @Success/@Failure schema the spec and the
+// handwritten annotations disagree about, in either direction.
+func diffResponses(funcName string, want, got map[string]responseAnno) []Drift {
+	var drifts []Drift
+	for code, w := range want {
+		g, ok := got[code]
+		if !ok {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Success " + code, Want: w.kind + " " + w.typ, Got: "<missing>"})
+			continue
+		}
+		if g.kind != w.kind || g.typ != w.typ {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Success " + code, Want: w.kind + " " + w.typ, Got: g.kind + " " + g.typ})
+		}
+	}
+	for code, g := range got {
+		if _, ok := want[code]; !ok {
+			drifts = append(drifts, Drift{FuncName: funcName, Field: "@Success " + code, Want: "<no longer in spec>", Got: g.kind + " " + g.typ})
+		}
+	}
+	return drifts
+}
+
+func routeKey(verb, path string) string {
+	return strings.ToUpper(verb) + " " + path
+}
+
+// parseAnnotations extracts the @Router, @Summary, @Param and
+// @Success/@Failure lines declared on each function's doc comment, keyed by
+// function name.
+func parseAnnotations(goFile string) (map[string]annotation, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, goFile, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("swagimport: parse %s: %w", goFile, err)
+	}
+
+	out := map[string]annotation{}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Doc == nil {
+			continue
+		}
+
+		a := annotation{responses: map[string]responseAnno{}}
+		for _, c := range fn.Doc.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			if m := routerRe.FindStringSubmatch(text); m != nil {
+				a.router, a.verb = m[1], m[2]
+			}
+			if m := summaryRe.FindStringSubmatch(text); m != nil {
+				a.summary = m[1]
+			}
+			if m := paramRe.FindStringSubmatch(text); m != nil {
+				a.params = append(a.params, paramAnno{name: m[1], in: m[2], typ: m[3], required: m[4] == "true"})
+			}
+			if m := responseRe.FindStringSubmatch(text); m != nil {
+				a.responses[m[1]] = responseAnno{kind: m[2], typ: m[3]}
+			}
+		}
+		if a.router != "" {
+			out[fn.Name.Name] = a
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,122 @@
+package swagimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+func writeSpecFixture(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "swagger.json")
+	spec := `{
+  "swagger": "2.0",
+  "paths": {
+    "/pets/{id}": {
+      "get": {
+        "summary": "Get a pet",
+        "parameters": [{"name": "id", "in": "path", "type": "integer", "required": true}],
+        "responses": {"200": {"schema": {"$ref": "#/definitions/main.Pet"}}}
+      }
+    }
+  }
+}`
+	if err := os.WriteFile(path, []byte(spec), 0o644); err != nil {
+		t.Fatalf("write fixture: %s", err)
+	}
+	return path
+}
+
+func writeGoFixture(t *testing.T, doc string) string {
+	t.Helper()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "handlers.go")
+	src := "package main\n\nimport \"net/http\"\n\n" + doc + "\nfunc getPet(w http.ResponseWriter, r *http.Request) {}\n"
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %s", err)
+	}
+	return path
+}
+
+func TestVerifyNoDrift(t *testing.T) {
+	specPath := writeSpecFixture(t)
+	goFile := writeGoFixture(t, `// getPet godoc
+// @Summary      Get a pet
+// @Param        id   path      integer  true  "id"
+// @Success      200  {object}  Pet
+// @Router       /pets/{id} [get]`)
+
+	drifts, err := Verify(specPath, goFile)
+	if err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+	if len(drifts) != 0 {
+		t.Errorf("Verify() = %v, want no drift", drifts)
+	}
+}
+
+func TestVerifyParamDrift(t *testing.T) {
+	specPath := writeSpecFixture(t)
+	goFile := writeGoFixture(t, `// getPet godoc
+// @Summary      Get a pet
+// @Param        id   path      string  false  "id"
+// @Success      200  {object}  Pet
+// @Router       /pets/{id} [get]`)
+
+	drifts, err := Verify(specPath, goFile)
+	if err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+	found := false
+	for _, d := range drifts {
+		if d.Field == "@Param path id" && d.Want == "integer required=true" && d.Got == "string required=false" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Verify() = %v, want an @Param path id drift", drifts)
+	}
+}
+
+func TestVerifyResponseDrift(t *testing.T) {
+	specPath := writeSpecFixture(t)
+	goFile := writeGoFixture(t, `// getPet godoc
+// @Summary      Get a pet
+// @Param        id   path      integer  true  "id"
+// @Success      200  {object}  Dog
+// @Router       /pets/{id} [get]`)
+
+	drifts, err := Verify(specPath, goFile)
+	if err != nil {
+		t.Fatalf("Verify: %s", err)
+	}
+	found := false
+	for _, d := range drifts {
+		if d.Field == "@Success 200" && d.Want == "{object} Pet" && d.Got == "{object} Dog" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Verify() = %v, want an @Success 200 drift", drifts)
+	}
+}
+
+func TestDiffParamsMissingAndExtra(t *testing.T) {
+	want := []paramAnno{{name: "id", in: "path", typ: "integer", required: true}}
+	got := []paramAnno{{name: "name", in: "query", typ: "string", required: false}}
+
+	drifts := diffParams("getPet", want, got)
+	if len(drifts) != 2 {
+		t.Fatalf("diffParams() = %v, want 2 drifts (one missing, one extra)", drifts)
+	}
+}
+
+func TestSchemaTypeNameUsesSharedShortName(t *testing.T) {
+	got := schemaTypeName(&swagclient.Schema{Ref: "#/definitions/main.Pet"})
+	if got != "Pet" {
+		t.Errorf("schemaTypeName() = %q, want %q", got, "Pet")
+	}
+}
@@ -0,0 +1,37 @@
+// Package swagvalidate builds net/http and Fiber middleware that validate
+// requests and responses against the swagger.json produced for a service,
+// so handlers like createPet/createTodo no longer need their own manual
+// BodyParser/json.Decode error branches.
+//
+// A Validator is built once from a swagger.json document and matches each
+// incoming request to the @Router/@Param/@Success annotations that produced
+// it, so validation failures can be reported with the same shape declared on
+// the operation (ErrorResponse) plus a JSON pointer to the offending field.
+//
+// Before, every handler re-validated its own body and hand-rolled the error
+// response:
+//
+//	func createPet(w http.ResponseWriter, r *http.Request) {
+//		var pet Pet
+//		if err := json.NewDecoder(r.Body).Decode(&pet); err != nil {
+//			w.WriteHeader(http.StatusBadRequest)
+//			json.NewEncoder(w).Encode(ErrorResponse{Code: 400, Message: "Invalid request"})
+//			return
+//		}
+//		...
+//	}
+//
+// After wiring Middleware in front of the mux, createPet can drop that
+// branch entirely: a request missing a required Pet field, or declaring one
+// with the wrong JSON type (e.g. a string where the schema says integer),
+// never reaches the handler -- it is rejected upstream with an
+// ErrorResponse carrying the offending field's JSON pointer.
+//
+//	handler := validator.Middleware(http.DefaultServeMux)
+//
+//	func createPet(w http.ResponseWriter, r *http.Request) {
+//		var pet Pet
+//		json.NewDecoder(r.Body).Decode(&pet) // shape already validated by Middleware
+//		...
+//	}
+package swagvalidate
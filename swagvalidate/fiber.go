@@ -0,0 +1,47 @@
+package swagvalidate
+
+import "github.com/gofiber/fiber/v2"
+
+// FiberMiddleware returns a Fiber handler equivalent to Middleware, for the
+// services built on github.com/gofiber/fiber/v2 (examples/gofiber,
+// example/fiber).
+func (v *Validator) FiberMiddleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		op, pathParams, swaggerPath, ok := v.Match(c.Method(), c.Path())
+		if !ok {
+			return c.Next()
+		}
+
+		lookup := func(in, name string) (string, bool) {
+			var val string
+			switch in {
+			case "query":
+				val = c.Query(name)
+			case "header":
+				val = c.Get(name)
+			}
+			return val, val != ""
+		}
+
+		errs := v.ValidateRequest(op, pathParams, lookup, c.Body())
+		v.report(c.Method(), swaggerPath, errs)
+
+		if len(errs) > 0 && v.cfg.Mode == ModeStrict {
+			return c.Status(fiber.StatusBadRequest).
+				JSON(newValidationErrorResponse(fiber.StatusBadRequest, "request failed validation", errs))
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		respErrs := v.ValidateResponse(op, c.Response().StatusCode(), c.Response().Body())
+		v.report(c.Method(), swaggerPath, respErrs)
+
+		if len(respErrs) > 0 && v.cfg.Mode == ModeStrict {
+			return c.Status(fiber.StatusInternalServerError).
+				JSON(newValidationErrorResponse(fiber.StatusInternalServerError, "response failed validation", respErrs))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,101 @@
+package swagvalidate
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+)
+
+// Middleware returns net/http middleware that validates each request against
+// the matching swagger.json operation before calling next, then validates
+// the response next wrote against the operation's declared success schema.
+// Unmatched routes (no @Router annotation, or listed in Config.Exclude) pass
+// through unchecked.
+func (v *Validator) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		op, pathParams, swaggerPath, ok := v.Match(r.Method, r.URL.Path)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rawBody, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewReader(rawBody))
+		if err != nil {
+			http.Error(w, "failed to read request body", http.StatusInternalServerError)
+			return
+		}
+
+		errs := v.ValidateRequest(op, pathParams, queryAndHeaderLookup(r), rawBody)
+		v.report(r.Method, swaggerPath, errs)
+
+		if len(errs) > 0 && v.cfg.Mode == ModeStrict {
+			writeValidationError(w, http.StatusBadRequest, "request failed validation", errs)
+			return
+		}
+
+		rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+
+		respErrs := v.ValidateResponse(op, rec.status, rec.body.Bytes())
+		v.report(r.Method, swaggerPath, respErrs)
+
+		if len(respErrs) > 0 && v.cfg.Mode == ModeStrict {
+			writeValidationError(w, http.StatusInternalServerError, "response failed validation", respErrs)
+			return
+		}
+
+		rec.flush()
+	})
+}
+
+func queryAndHeaderLookup(r *http.Request) func(in, name string) (string, bool) {
+	return func(in, name string) (string, bool) {
+		switch in {
+		case "query":
+			values := r.URL.Query()
+			if !values.Has(name) {
+				return "", false
+			}
+			return values.Get(name), true
+		case "header":
+			val := r.Header.Get(name)
+			return val, val != ""
+		default:
+			return "", false
+		}
+	}
+}
+
+func writeValidationError(w http.ResponseWriter, status int, message string, errs []FieldError) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(newValidationErrorResponse(status, message, errs).json())
+}
+
+// responseRecorder buffers a handler's response so it can be validated
+// before being flushed to the real client.
+type responseRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.wroteHeader = true
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+// flush writes the buffered status and body to the underlying ResponseWriter.
+func (r *responseRecorder) flush() {
+	if r.wroteHeader {
+		r.ResponseWriter.WriteHeader(r.status)
+	}
+	r.ResponseWriter.Write(r.body.Bytes())
+}
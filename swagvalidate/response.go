@@ -0,0 +1,31 @@
+package swagvalidate
+
+import "encoding/json"
+
+// ErrorResponse mirrors the {code, message} shape declared via @Failure
+// across the example services (example/, examples/http, examples/gofiber).
+// ValidationErrorResponse embeds it so a strict-mode rejection still looks
+// like a documented ErrorResponse to clients that only read those fields.
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// ValidationErrorResponse is written on a validation failure. Errors carries
+// one entry per offending field, addressed by JSON Pointer.
+type ValidationErrorResponse struct {
+	ErrorResponse
+	Errors []FieldError `json:"errors"`
+}
+
+func newValidationErrorResponse(code int, message string, errs []FieldError) ValidationErrorResponse {
+	return ValidationErrorResponse{
+		ErrorResponse: ErrorResponse{Code: code, Message: message},
+		Errors:        errs,
+	}
+}
+
+func (r ValidationErrorResponse) json() []byte {
+	data, _ := json.Marshal(r)
+	return data
+}
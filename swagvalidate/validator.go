@@ -0,0 +1,338 @@
+package swagvalidate
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+// Mode selects how a Validator reacts to a violation.
+type Mode int
+
+const (
+	// ModeStrict rejects the request/response and returns the declared
+	// ErrorResponse shape.
+	ModeStrict Mode = iota
+	// ModeWarn records violations (via Config.OnViolation) but otherwise
+	// lets the request through unchanged.
+	ModeWarn
+)
+
+// FieldError is a single validation failure, pointing at the offending
+// field with a JSON Pointer (RFC 6901) relative to the request or response
+// body, e.g. "/name"; a missing/invalid parameter is addressed the same way
+// by its name (e.g. "/id"), and a body that is not valid JSON gets "".
+type FieldError struct {
+	Pointer string `json:"pointer"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Pointer == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Pointer, e.Message)
+}
+
+// Violation is returned to Config.OnViolation and carries every FieldError
+// found for a single request.
+type Violation struct {
+	Method string
+	Path   string
+	Errors []FieldError
+}
+
+// Config controls how a Validator behaves.
+type Config struct {
+	// Mode is ModeStrict unless set otherwise.
+	Mode Mode
+	// Exclude lists swagger path templates (e.g. "/pets/{id}") that should
+	// never be validated, for routes that opt out of enforcement.
+	Exclude []string
+	// OnViolation, if set, is called for every violation found, in both
+	// ModeStrict and ModeWarn. Useful for logging/metrics.
+	OnViolation func(Violation)
+}
+
+// Validator matches requests against a parsed swagger.json document and
+// checks them against the declared parameter and schema definitions.
+type Validator struct {
+	spec    *swagclient.Spec
+	cfg     Config
+	exclude map[string]bool
+	routes  []route
+}
+
+type route struct {
+	verb    string
+	pattern *regexp.Regexp
+	names   []string
+	path    string
+	op      *swagclient.Operation
+}
+
+// New builds a Validator from the swagger.json document at specPath.
+func New(specPath string, cfg Config) (*Validator, error) {
+	spec, err := swagclient.LoadSpec(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Validator{spec: spec, cfg: cfg, exclude: map[string]bool{}}
+	for _, p := range cfg.Exclude {
+		v.exclude[p] = true
+	}
+
+	for path, item := range spec.Paths {
+		for _, pair := range item.Operations() {
+			v.routes = append(v.routes, route{
+				verb:    pair.Verb,
+				pattern: compilePathPattern(path),
+				names:   swagclient.PathParamNames(path),
+				path:    path,
+				op:      pair.Op,
+			})
+		}
+	}
+	return v, nil
+}
+
+// compilePathPattern turns a swagger path template like "/pets/{id}" into a
+// regexp that captures each {param} as a named group. Named capture groups
+// only allow word characters, so parameter names are mapped to g0, g1, ...
+// and matched back up by position rather than by name.
+func compilePathPattern(path string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	rest := path
+	for i, name := range swagclient.PathParamNames(path) {
+		placeholder := "{" + name + "}"
+		j := strings.Index(rest, placeholder)
+		b.WriteString(regexp.QuoteMeta(rest[:j]))
+		fmt.Fprintf(&b, "(?P<g%d>[^/]+)", i)
+		rest = rest[j+len(placeholder):]
+	}
+	b.WriteString(regexp.QuoteMeta(rest))
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// Match finds the operation and extracted path parameters for a request
+// method and path, honoring Config.Exclude. ok is false when there is no
+// matching documented route or the route opted out of validation.
+func (v *Validator) Match(method, path string) (op *swagclient.Operation, pathParams map[string]string, swaggerPath string, ok bool) {
+	path = strings.TrimPrefix(path, v.spec.BasePath)
+	if path == "" {
+		path = "/"
+	}
+	for _, r := range v.routes {
+		if !strings.EqualFold(r.verb, method) {
+			continue
+		}
+		m := r.pattern.FindStringSubmatch(path)
+		if m == nil {
+			continue
+		}
+		if v.exclude[r.path] {
+			return nil, nil, r.path, false
+		}
+		params := map[string]string{}
+		for i, name := range r.names {
+			params[name] = m[i+1]
+		}
+		return r.op, params, r.path, true
+	}
+	return nil, nil, "", false
+}
+
+// ValidateParams checks that every declared path/query/header parameter is
+// present and, for integer/boolean parameters, well-formed. queryAndHeader
+// looks up a non-path parameter's raw value by name; ok reports whether it
+// was supplied at all.
+func (v *Validator) ValidateParams(op *swagclient.Operation, pathParams map[string]string, queryAndHeader func(in, name string) (string, bool)) []FieldError {
+	var errs []FieldError
+	for _, p := range op.Parameters {
+		if p.In == "body" {
+			continue
+		}
+
+		var raw string
+		var present bool
+		if p.In == "path" {
+			raw, present = pathParams[p.Name]
+		} else {
+			raw, present = queryAndHeader(p.In, p.Name)
+		}
+
+		if !present || raw == "" {
+			if p.Required {
+				errs = append(errs, FieldError{Pointer: "/" + p.Name, Message: "required " + p.In + " parameter is missing"})
+			}
+			continue
+		}
+
+		switch p.Type {
+		case "integer":
+			if _, err := strconv.ParseInt(raw, 10, 64); err != nil {
+				errs = append(errs, FieldError{Pointer: "/" + p.Name, Message: "must be an integer"})
+			}
+		case "boolean":
+			if _, err := strconv.ParseBool(raw); err != nil {
+				errs = append(errs, FieldError{Pointer: "/" + p.Name, Message: "must be a boolean"})
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateBody checks a decoded JSON request/response body against the
+// referenced schema's declared required properties and, for properties that
+// are present, their declared type.
+func (v *Validator) ValidateBody(schema *swagclient.Schema, body map[string]interface{}) []FieldError {
+	if schema == nil {
+		return nil
+	}
+	resolved := v.resolve(*schema)
+
+	var errs []FieldError
+	for _, name := range resolved.Required {
+		if _, ok := body[name]; !ok {
+			errs = append(errs, FieldError{Pointer: "/" + name, Message: "required field is missing"})
+		}
+	}
+	for name, prop := range resolved.Properties {
+		value, ok := body[name]
+		if !ok || prop.Type == "" {
+			continue
+		}
+		if !jsonKindMatches(prop.Type, value) {
+			errs = append(errs, FieldError{Pointer: "/" + name, Message: fmt.Sprintf("must be a %s, got %s", prop.Type, jsonKindName(value))})
+		}
+	}
+	return errs
+}
+
+// jsonKindMatches reports whether a JSON-decoded value's runtime type
+// agrees with a declared swagger type ("string", "integer", "number",
+// "boolean", "array" or "object"). encoding/json decodes all JSON numbers
+// as float64, so "integer" is accepted for any float64 value; Swagger
+// doesn't distinguish the two at decode time either.
+func jsonKindMatches(schemaType string, value interface{}) bool {
+	switch schemaType {
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "integer", "number":
+		_, ok := value.(float64)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	default:
+		return true
+	}
+}
+
+// jsonKindName names a decoded JSON value's kind for FieldError messages.
+func jsonKindName(value interface{}) string {
+	switch value.(type) {
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	case nil:
+		return "null"
+	default:
+		return "unknown"
+	}
+}
+
+// ValidateRequest validates a request's path/query/header parameters and,
+// when the operation declares a body parameter, its decoded JSON body.
+func (v *Validator) ValidateRequest(op *swagclient.Operation, pathParams map[string]string, lookup func(in, name string) (string, bool), rawBody []byte) []FieldError {
+	errs := v.ValidateParams(op, pathParams, lookup)
+
+	if schema := v.BodySchema(op); schema != nil && len(rawBody) > 0 {
+		var body map[string]interface{}
+		if err := json.Unmarshal(rawBody, &body); err != nil {
+			errs = append(errs, FieldError{Message: "body is not valid JSON"})
+		} else {
+			errs = append(errs, v.ValidateBody(schema, body)...)
+		}
+	}
+	return errs
+}
+
+// ValidateResponse validates a handler's JSON response body against the
+// schema declared for the status code it returned. It reports nothing for
+// status codes / array responses that aren't modeled with an object schema.
+func (v *Validator) ValidateResponse(op *swagclient.Operation, status int, rawBody []byte) []FieldError {
+	schema := v.SuccessSchema(op, status)
+	if schema == nil || schema.Type == "array" || len(rawBody) == 0 {
+		return nil
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(rawBody, &body); err != nil {
+		return []FieldError{{Message: "response body is not valid JSON"}}
+	}
+	return v.ValidateBody(schema, body)
+}
+
+// BodySchema returns the body parameter's schema for an operation, or nil
+// when the operation declares no body parameter.
+func (v *Validator) BodySchema(op *swagclient.Operation) *swagclient.Schema {
+	for _, p := range op.Parameters {
+		if p.In == "body" {
+			return p.Schema
+		}
+	}
+	return nil
+}
+
+// SuccessSchema returns the declared schema for a response status code, or
+// nil when that status isn't documented with one.
+func (v *Validator) SuccessSchema(op *swagclient.Operation, status int) *swagclient.Schema {
+	resp, ok := op.Responses[strconv.Itoa(status)]
+	if !ok || resp.Schema == nil {
+		return nil
+	}
+	return resp.Schema
+}
+
+// resolve follows a single-level $ref into spec.Definitions so callers can
+// read Required/Properties off a schema that only carries a $ref.
+func (v *Validator) resolve(schema swagclient.Schema) swagclient.Schema {
+	if schema.Ref == "" {
+		return schema
+	}
+	parts := strings.Split(schema.Ref, "/")
+	name := parts[len(parts)-1]
+	if def, ok := v.spec.Definitions[name]; ok {
+		return def
+	}
+	return schema
+}
+
+// report invokes Config.OnViolation, if set, when errs is non-empty.
+func (v *Validator) report(method, path string, errs []FieldError) {
+	if len(errs) == 0 || v.cfg.OnViolation == nil {
+		return
+	}
+	v.cfg.OnViolation(Violation{Method: method, Path: path, Errors: errs})
+}
@@ -0,0 +1,153 @@
+package swagvalidate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/NKI-AI/rules-go-swag/swagclient"
+)
+
+const testSwagger = `{
+  "swagger": "2.0",
+  "basePath": "/api/v1",
+  "info": {"title": "Pet Store API", "version": "1.0"},
+  "paths": {
+    "/pets/{id}": {
+      "get": {
+        "parameters": [{"name": "id", "in": "path", "required": true, "type": "integer"}],
+        "responses": {"200": {"description": "ok", "schema": {"$ref": "#/definitions/main.Pet"}}}
+      }
+    },
+    "/pets": {
+      "post": {
+        "parameters": [{"name": "pet", "in": "body", "schema": {"$ref": "#/definitions/main.Pet"}}],
+        "responses": {"201": {"description": "created", "schema": {"$ref": "#/definitions/main.Pet"}}}
+      }
+    }
+  },
+  "definitions": {
+    "main.Pet": {"type": "object", "required": ["name"], "properties": {"id": {"type": "integer"}, "name": {"type": "string"}}}
+  }
+}`
+
+func newTestValidator(t *testing.T, cfg Config) *Validator {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "swagger.json")
+	if err := os.WriteFile(path, []byte(testSwagger), 0o644); err != nil {
+		t.Fatalf("write fixture: %s", err)
+	}
+	v, err := New(path, cfg)
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+	return v
+}
+
+func TestMatchStripsBasePath(t *testing.T) {
+	v := newTestValidator(t, Config{})
+
+	op, params, swaggerPath, ok := v.Match("GET", "/api/v1/pets/42")
+	if !ok {
+		t.Fatal("Match: ok = false, want true")
+	}
+	if swaggerPath != "/pets/{id}" {
+		t.Errorf("swaggerPath = %q, want %q", swaggerPath, "/pets/{id}")
+	}
+	if params["id"] != "42" {
+		t.Errorf("params[id] = %q, want %q", params["id"], "42")
+	}
+	if op == nil {
+		t.Fatal("op is nil")
+	}
+}
+
+func TestMatchExclude(t *testing.T) {
+	v := newTestValidator(t, Config{Exclude: []string{"/pets/{id}"}})
+
+	_, _, _, ok := v.Match("GET", "/api/v1/pets/42")
+	if ok {
+		t.Error("Match: ok = true for an excluded route, want false")
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	v := newTestValidator(t, Config{})
+	op, pathParams, _, ok := v.Match("GET", "/api/v1/pets/abc")
+	if !ok {
+		t.Fatal("Match: no route found")
+	}
+
+	errs := v.ValidateParams(op, pathParams, func(string, string) (string, bool) { return "", false })
+	if len(errs) != 1 || errs[0].Pointer != "/id" {
+		t.Fatalf("got errors %+v, want a single /id error (non-integer path param)", errs)
+	}
+}
+
+func TestValidateRequestBody(t *testing.T) {
+	v := newTestValidator(t, Config{})
+	op, pathParams, _, ok := v.Match("POST", "/api/v1/pets")
+	if !ok {
+		t.Fatal("Match: no route found")
+	}
+
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{"valid body", `{"name":"Fluffy"}`, false},
+		{"missing required field", `{}`, true},
+		{"wrong type for present field", `{"name":123,"tag":"cat"}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := v.ValidateRequest(op, pathParams, func(string, string) (string, bool) { return "", false }, []byte(tt.body))
+			if tt.wantErr != (len(errs) > 0) {
+				t.Errorf("ValidateRequest(%q) errs = %+v, wantErr = %t", tt.body, errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateBodyRejectsWrongPropertyType(t *testing.T) {
+	v := newTestValidator(t, Config{})
+	schema := &swagclient.Schema{Ref: "#/definitions/main.Pet"}
+
+	errs := v.ValidateBody(schema, map[string]interface{}{"name": float64(123)})
+	if len(errs) != 1 || errs[0].Pointer != "/name" {
+		t.Fatalf("ValidateBody(wrong-typed name) = %+v, want a single /name type error", errs)
+	}
+
+	if errs := v.ValidateBody(schema, map[string]interface{}{"name": "Fluffy", "id": float64(1)}); len(errs) != 0 {
+		t.Errorf("ValidateBody(correctly-typed body) = %+v, want none", errs)
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	v := newTestValidator(t, Config{})
+	op, _, _, ok := v.Match("POST", "/api/v1/pets")
+	if !ok {
+		t.Fatal("Match: no route found")
+	}
+
+	if errs := v.ValidateResponse(op, 201, []byte(`{"id":1,"name":"Fluffy"}`)); len(errs) != 0 {
+		t.Errorf("ValidateResponse(valid) = %+v, want none", errs)
+	}
+	if errs := v.ValidateResponse(op, 201, []byte(`{"id":1}`)); len(errs) == 0 {
+		t.Errorf("ValidateResponse(missing name) = %+v, want a /name error", errs)
+	}
+}
+
+func TestCompilePathPatternUsesSharedPathParamNames(t *testing.T) {
+	names := swagclient.PathParamNames("/pets/{id}/toys/{toyId}")
+	if len(names) != 2 || names[0] != "id" || names[1] != "toyId" {
+		t.Fatalf("swagclient.PathParamNames = %v, want [id toyId]", names)
+	}
+
+	re := compilePathPattern("/pets/{id}/toys/{toyId}")
+	m := re.FindStringSubmatch("/pets/1/toys/2")
+	if m == nil || m[1] != "1" || m[2] != "2" {
+		t.Fatalf("compilePathPattern match = %v, want [1 2]", m)
+	}
+}